@@ -17,6 +17,7 @@ limitations under the License.
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
 
@@ -24,14 +25,20 @@ import (
 	// (only required to authenticate against GKE clusters).
 	// _ "k8s.io/client-go/plugin/pkg/client/auth/gcp"
 
+	cloudevents "github.com/cloudevents/sdk-go/v2"
 	"github.com/kelseyhightower/envconfig"
 	"go.uber.org/zap"
+	authorizationv1 "k8s.io/api/authorization/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/restmapper"
 	"k8s.io/client-go/tools/clientcmd"
 	"knative.dev/eventing/pkg/adapter/apiserver"
-	"knative.dev/eventing/pkg/kncloudevents"
+	"knative.dev/eventing/pkg/adapter/apiserver/converters"
 	"knative.dev/eventing/pkg/tracing"
 	"knative.dev/eventing/pkg/utils"
 	"knative.dev/pkg/logging"
@@ -50,10 +57,13 @@ var (
 
 type envConfig struct {
 	Namespace         string   `envconfig:"SYSTEM_NAMESPACE" default:"default"`
+	Name              string   `envconfig:"NAME" required:"true"`
 	Mode              string   `envconfig:"MODE"`
+	Converter         string   `envconfig:"CONVERTER"`
 	SinkURI           string   `split_words:"true" required:"true"`
 	ApiVersion        []string `split_words:"true" required:"true"`
 	Kind              []string `required:"true"`
+	Resource          []string `required:"true"`
 	Controller        []bool   `required:"true"`
 	ApiServerImporter string   `envconfig:"APISERVERIMPORTER" required:"true"`
 	// MetricsConfigBase64 is a base64 encoded json string of
@@ -68,8 +78,6 @@ type envConfig struct {
 	LoggingConfigBase64 string `envconfig:"K_LOGGING_CONFIG" required:"true"`
 }
 
-// TODO: the controller should take the list of GVR
-
 func main() {
 	flag.Parse()
 
@@ -124,6 +132,16 @@ func main() {
 		logger.Fatalw("Error building dynamic client", zap.Error(err))
 	}
 
+	kubeClient, err := kubernetes.NewForConfig(cfg)
+	if err != nil {
+		logger.Fatalw("Error building kubernetes clientset", zap.Error(err))
+	}
+
+	mapper, err := buildRESTMapper(kubeClient.Discovery())
+	if err != nil {
+		logger.Fatalw("Error building REST mapper", zap.Error(err))
+	}
+
 	if err = tracing.SetupStaticPublishing(logger, "apiserversource",
 		tracing.OnePercentSampling); err != nil {
 		// If tracing doesn't work, we will log an error, but allow the importer
@@ -131,44 +149,113 @@ func main() {
 		logger.Error("Error setting up trace publishing", zap.Error(err))
 	}
 
-	eventsClient, err := kncloudevents.NewDefaultClient(env.SinkURI)
+	eventsClient, err := cloudevents.NewClientHTTP()
 	if err != nil {
 		logger.Fatalw("Error building cloud event client", zap.Error(err))
 	}
 
-	gvrcs := []apiserver.GVRC(nil)
+	gvrcs, err := resolveGVRCs(mapper, kubeClient, env)
+	if err != nil {
+		logger.Fatalw("Error resolving watched resources", zap.Error(err))
+	}
+
+	converter, err := converters.FromName(env.Converter)
+	if err != nil {
+		logger.Fatalw("Error building converter", zap.Error(err))
+	}
+
+	opt := apiserver.Options{
+		Namespace: env.Namespace,
+		Mode:      env.Mode,
+		GVRCs:     gvrcs,
+		Converter: converter,
+	}
+
+	a := apiserver.NewAdaptor(cfg.Host, client, eventsClient, env.SinkURI, logger, opt,
+		reporter, env.ApiServerImporter)
+
+	gvrcUpdates := make(chan []apiserver.GVRC)
+	go watchOwningSource(client, mapper, env.Namespace, env.Name, gvrcUpdates, stopCh, logger)
+
+	logger.Info("starting kubernetes api adapter.", zap.Any("adapter", env))
+	if err := a.Start(stopCh, gvrcUpdates); err != nil {
+		logger.Warn("start returned an error,", zap.Error(err))
+	}
+}
+
+// resolveGVRCs builds the GroupVersionResource for each ApiVersion/Kind/
+// Resource triple in env, validating that the resource exists and that this
+// adapter's ServiceAccount is allowed to watch it.
+func resolveGVRCs(mapper meta.RESTMapper, kubeClient kubernetes.Interface, env envConfig) ([]apiserver.GVRC, error) {
+	var gvrcs []apiserver.GVRC
 
 	for i, apiVersion := range env.ApiVersion {
-		kind := env.Kind[i]
+		resource := env.Resource[i]
 		controlled := env.Controller[i]
 
 		gv, err := schema.ParseGroupVersion(apiVersion)
 		if err != nil {
-			logger.Fatalw("Error parsing APIVersion", zap.Error(err))
+			return nil, fmt.Errorf("parsing APIVersion %q: %w", apiVersion, err)
+		}
+		gvr := gv.WithResource(resource)
+
+		if err := validateGVR(mapper, gvr); err != nil {
+			return nil, err
+		}
+		if err := checkWatchAccess(kubeClient, gvr, env.Namespace); err != nil {
+			return nil, err
 		}
-		// TODO: pass down the resource and the kind so we do not have to guess.
-		gvr, _ := meta.UnsafeGuessKindToResource(schema.GroupVersionKind{
-			Kind:    kind,
-			Group:   gv.Group,
-			Version: gv.Version})
+
 		gvrcs = append(gvrcs, apiserver.GVRC{
 			GVR:        gvr,
 			Controller: controlled,
 		})
 	}
 
-	opt := apiserver.Options{
-		Namespace: env.Namespace,
-		Mode:      env.Mode,
-		GVRCs:     gvrcs,
+	return gvrcs, nil
+}
+
+// buildRESTMapper builds a RESTMapper from the cluster's discovered API
+// group resources, used to validate that a watched GVR actually exists.
+func buildRESTMapper(dc discovery.DiscoveryInterface) (meta.RESTMapper, error) {
+	groupResources, err := restmapper.GetAPIGroupResources(dc)
+	if err != nil {
+		return nil, fmt.Errorf("discovering API group resources: %w", err)
 	}
+	return restmapper.NewDiscoveryRESTMapper(groupResources), nil
+}
 
-	a := apiserver.NewAdaptor(cfg.Host, client, eventsClient, logger, opt,
-		reporter, env.ApiServerImporter)
-	logger.Info("starting kubernetes api adapter.", zap.Any("adapter", env))
-	if err := a.Start(stopCh); err != nil {
-		logger.Warn("start returned an error,", zap.Error(err))
+// validateGVR returns a clear error if gvr does not exist in the cluster.
+func validateGVR(mapper meta.RESTMapper, gvr schema.GroupVersionResource) error {
+	if _, err := mapper.KindFor(gvr); err != nil {
+		return fmt.Errorf("resource %q does not exist: %w", gvr, err)
+	}
+	return nil
+}
+
+// checkWatchAccess returns a clear error if this adapter's ServiceAccount is
+// not allowed to watch gvr in namespace.
+func checkWatchAccess(kubeClient kubernetes.Interface, gvr schema.GroupVersionResource, namespace string) error {
+	ssar := &authorizationv1.SelfSubjectAccessReview{
+		Spec: authorizationv1.SelfSubjectAccessReviewSpec{
+			ResourceAttributes: &authorizationv1.ResourceAttributes{
+				Namespace: namespace,
+				Verb:      "watch",
+				Group:     gvr.Group,
+				Version:   gvr.Version,
+				Resource:  gvr.Resource,
+			},
+		},
+	}
+
+	res, err := kubeClient.AuthorizationV1().SelfSubjectAccessReviews().Create(context.Background(), ssar, metav1.CreateOptions{})
+	if err != nil {
+		return fmt.Errorf("checking watch access for %q: %w", gvr, err)
+	}
+	if !res.Status.Allowed {
+		return fmt.Errorf("this adapter's ServiceAccount is not allowed to watch %q in namespace %q: %s", gvr, namespace, res.Status.Reason)
 	}
+	return nil
 }
 
 func flush(logger *zap.SugaredLogger) {
@@ -0,0 +1,126 @@
+/*
+Copyright 2019 The Knative Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/tools/cache"
+
+	"knative.dev/eventing/pkg/adapter/apiserver"
+)
+
+// apiServerSourceGVR is the GroupVersionResource of the ApiServerSource that
+// owns this adapter instance.
+var apiServerSourceGVR = schema.GroupVersionResource{
+	Group:    "sources.knative.dev",
+	Version:  "v1alpha2",
+	Resource: "apiserversources",
+}
+
+// ownerInformerResync is how often the informer watching the owning
+// ApiServerSource resyncs its local cache against the API server.
+const ownerInformerResync = 10 * time.Hour
+
+// watchOwningSource watches the ApiServerSource named name in namespace and
+// sends its resolved GVRCs on updates every time its spec.resources change,
+// until stopCh is closed. This lets the ApiServerSource controller
+// reconcile resource list changes without a pod restart.
+func watchOwningSource(client dynamic.Interface, mapper meta.RESTMapper, namespace, name string,
+	updates chan<- []apiserver.GVRC, stopCh <-chan struct{}, logger *zap.SugaredLogger) {
+	factory := dynamicinformer.NewFilteredDynamicSharedInformerFactory(client, ownerInformerResync, namespace,
+		func(opts *metav1.ListOptions) {
+			opts.FieldSelector = "metadata.name=" + name
+		})
+	informer := factory.ForResource(apiServerSourceGVR).Informer()
+
+	handle := func(obj interface{}) {
+		u, ok := obj.(*unstructured.Unstructured)
+		if !ok {
+			logger.Errorw("unexpected object type from owning source informer", zap.Any("obj", obj))
+			return
+		}
+
+		gvrcs, err := gvrcsFromApiServerSource(mapper, u)
+		if err != nil {
+			logger.Errorw("failed to resolve GVRCs from the owning ApiServerSource", zap.Error(err))
+			return
+		}
+
+		select {
+		case updates <- gvrcs:
+		case <-stopCh:
+		}
+	}
+
+	informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: handle,
+		UpdateFunc: func(_, newObj interface{}) {
+			handle(newObj)
+		},
+	})
+	informer.Run(stopCh)
+}
+
+// gvrcsFromApiServerSource reads the watched resources out of an
+// ApiServerSource's spec.resources field and resolves each into a GVRC,
+// validating it against mapper.
+func gvrcsFromApiServerSource(mapper meta.RESTMapper, source *unstructured.Unstructured) ([]apiserver.GVRC, error) {
+	resources, found, err := unstructured.NestedSlice(source.Object, "spec", "resources")
+	if err != nil {
+		return nil, fmt.Errorf("reading spec.resources: %w", err)
+	}
+	if !found {
+		return nil, nil
+	}
+
+	var gvrcs []apiserver.GVRC
+	for _, r := range resources {
+		m, ok := r.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("spec.resources entry %v is not an object", r)
+		}
+
+		apiVersion, _, _ := unstructured.NestedString(m, "apiVersion")
+		kind, _, _ := unstructured.NestedString(m, "kind")
+		controller, _, _ := unstructured.NestedBool(m, "controller")
+
+		gv, err := schema.ParseGroupVersion(apiVersion)
+		if err != nil {
+			return nil, fmt.Errorf("parsing apiVersion %q: %w", apiVersion, err)
+		}
+
+		restMapping, err := mapper.RESTMapping(gv.WithKind(kind).GroupKind(), gv.Version)
+		if err != nil {
+			return nil, fmt.Errorf("resolving resource for kind %q: %w", kind, err)
+		}
+
+		gvrcs = append(gvrcs, apiserver.GVRC{
+			GVR:        restMapping.Resource,
+			Controller: controller,
+		})
+	}
+	return gvrcs, nil
+}
@@ -0,0 +1,217 @@
+/*
+Copyright 2019 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserver
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/tools/cache"
+	apiservercontext "knative.dev/eventing/pkg/adapter/apiserver/context"
+	"knative.dev/eventing/pkg/adapter/apiserver/converters"
+)
+
+// defaultResync is how often the informers started by Adaptor resync their
+// local caches against the API server.
+const defaultResync = 10 * time.Hour
+
+// GVRC pairs a watched resource with the Controller flag configured for it.
+// Adaptor does not read Controller: it forwards every object it observes
+// for the resource, whatever the flag's value.
+type GVRC struct {
+	GVR        schema.GroupVersionResource
+	Controller bool
+}
+
+// Options configures an Adaptor.
+type Options struct {
+	Namespace string
+	Mode      string
+	GVRCs     []GVRC
+	Converter converters.Converter
+}
+
+// Adaptor watches the configured GVRCs and forwards what it observes to
+// a sink as CloudEvents.
+type Adaptor struct {
+	host              string
+	client            dynamic.Interface
+	ceClient          cloudevents.Client
+	sink              string
+	logger            *zap.SugaredLogger
+	opt               Options
+	reporter          StatsReporter
+	apiServerImporter string
+
+	mu        sync.Mutex
+	informers map[schema.GroupVersionResource]*runningInformer
+}
+
+// runningInformer tracks the stop channel for a single GVR's informer, so
+// it can be stopped independently of the others when Adaptor reconciles a
+// new set of GVRCs.
+type runningInformer struct {
+	stop chan struct{}
+	once sync.Once
+}
+
+// Stop closes the informer's stop channel exactly once, whether it is
+// triggered by a reconcile removing this GVR or by the adapter's own
+// shutdown.
+func (r *runningInformer) Stop() {
+	r.once.Do(func() { close(r.stop) })
+}
+
+// NewAdaptor creates an Adaptor that watches opt.GVRCs and forwards the
+// events it observes to sink over ceClient.
+func NewAdaptor(host string, client dynamic.Interface, ceClient cloudevents.Client, sink string, logger *zap.SugaredLogger,
+	opt Options, reporter StatsReporter, apiServerImporter string) *Adaptor {
+	return &Adaptor{
+		host:              host,
+		client:            client,
+		ceClient:          ceClient,
+		sink:              sink,
+		logger:            logger,
+		opt:               opt,
+		reporter:          reporter,
+		apiServerImporter: apiServerImporter,
+	}
+}
+
+// Start runs an informer for every GVRC in opt.GVRCs, and reconciles that
+// set of informers every time a new list of GVRCs is sent on updates,
+// starting and stopping individual informers without restarting the
+// others. It returns once stopCh is closed.
+func (a *Adaptor) Start(stopCh <-chan struct{}, updates <-chan []GVRC) error {
+	a.informers = make(map[schema.GroupVersionResource]*runningInformer)
+	a.reconcile(a.opt.GVRCs, stopCh)
+
+	for {
+		select {
+		case gvrcs := <-updates:
+			a.reconcile(gvrcs, stopCh)
+		case <-stopCh:
+			return nil
+		}
+	}
+}
+
+// reconcile starts an informer for every GVRC in gvrcs that isn't already
+// running, and stops every running informer whose GVR is no longer in
+// gvrcs.
+func (a *Adaptor) reconcile(gvrcs []GVRC, stopCh <-chan struct{}) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	desired := make(map[schema.GroupVersionResource]GVRC, len(gvrcs))
+	for _, gvrc := range gvrcs {
+		desired[gvrc.GVR] = gvrc
+	}
+
+	for gvr, running := range a.informers {
+		if _, ok := desired[gvr]; !ok {
+			running.Stop()
+			delete(a.informers, gvr)
+		}
+	}
+
+	for gvr, gvrc := range desired {
+		if _, ok := a.informers[gvr]; ok {
+			continue
+		}
+		a.informers[gvr] = a.startInformer(gvrc, stopCh)
+	}
+}
+
+// startInformer starts an informer for gvrc and returns the runningInformer
+// that stops it, either when stopCh closes or when it is explicitly
+// stopped by a later reconcile.
+func (a *Adaptor) startInformer(gvrc GVRC, stopCh <-chan struct{}) *runningInformer {
+	running := &runningInformer{stop: make(chan struct{})}
+	go func() {
+		select {
+		case <-stopCh:
+			running.Stop()
+		case <-running.stop:
+		}
+	}()
+
+	factory := dynamicinformer.NewFilteredDynamicSharedInformerFactory(a.client, defaultResync, a.opt.Namespace, nil)
+	informer := factory.ForResource(gvrc.GVR).Informer()
+	informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			a.handleEvent(obj, "add")
+		},
+		UpdateFunc: func(_, newObj interface{}) {
+			a.handleEvent(newObj, "update")
+		},
+		DeleteFunc: func(obj interface{}) {
+			a.handleEvent(obj, "delete")
+		},
+	})
+	go informer.Run(running.stop)
+
+	return running
+}
+
+// handleEvent converts the watched object into a CloudEvent via the
+// configured converter, sends it to the sink, and reports the resulting
+// count and dispatch time.
+func (a *Adaptor) handleEvent(obj interface{}, action string) {
+	u, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		a.logger.Errorw("unexpected object type from informer", zap.Any("obj", obj))
+		return
+	}
+
+	event, err := a.opt.Converter.Convert(context.Background(), u, action)
+	if err != nil {
+		a.logger.Errorw("failed to convert object to a cloudevent", zap.Error(err))
+		return
+	}
+
+	ctx := apiservercontext.WithNamespace(a.baseContext(), u.GetNamespace())
+	ctx = apiservercontext.WithEventType(ctx, event.Type())
+	ctx = apiservercontext.WithEventSource(ctx, event.Source())
+
+	start := time.Now()
+	result := a.ceClient.Send(cloudevents.ContextWithTarget(ctx, a.sink), event)
+	dispatchTime := time.Since(start)
+
+	if !cloudevents.IsACK(result) {
+		a.logger.Errorw("failed to send event to sink", zap.Error(result))
+	}
+	if err := a.reporter.ReportEventCount(ctx, result); err != nil {
+		a.logger.Errorw("failed to report event count", zap.Error(err))
+	}
+	if err := a.reporter.ReportEventDispatchTime(ctx, result, dispatchTime); err != nil {
+		a.logger.Errorw("failed to report event dispatch time", zap.Error(err))
+	}
+}
+
+// baseContext returns the context every handleEvent call starts from,
+// carrying the tags that are the same for every event this Adaptor sends.
+func (a *Adaptor) baseContext() context.Context {
+	return apiservercontext.WithImporterName(context.Background(), a.apiServerImporter)
+}
@@ -0,0 +1,73 @@
+/*
+Copyright 2019 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package context carries the apiserver adapter's per-event tags
+// (namespace, event type, event source, importer name) on a
+// context.Context, so they can be threaded through a CloudEvents Send call
+// and read back out by the StatsReporter on the other side.
+package context
+
+import "context"
+
+type namespaceKey struct{}
+type eventTypeKey struct{}
+type eventSourceKey struct{}
+type importerNameKey struct{}
+
+// WithNamespace returns a copy of ctx carrying ns.
+func WithNamespace(ctx context.Context, ns string) context.Context {
+	return context.WithValue(ctx, namespaceKey{}, ns)
+}
+
+// Namespace returns the namespace carried by ctx, or "" if none was set.
+func Namespace(ctx context.Context) string {
+	ns, _ := ctx.Value(namespaceKey{}).(string)
+	return ns
+}
+
+// WithEventType returns a copy of ctx carrying eventType.
+func WithEventType(ctx context.Context, eventType string) context.Context {
+	return context.WithValue(ctx, eventTypeKey{}, eventType)
+}
+
+// EventType returns the event type carried by ctx, or "" if none was set.
+func EventType(ctx context.Context) string {
+	eventType, _ := ctx.Value(eventTypeKey{}).(string)
+	return eventType
+}
+
+// WithEventSource returns a copy of ctx carrying eventSource.
+func WithEventSource(ctx context.Context, eventSource string) context.Context {
+	return context.WithValue(ctx, eventSourceKey{}, eventSource)
+}
+
+// EventSource returns the event source carried by ctx, or "" if none was set.
+func EventSource(ctx context.Context) string {
+	eventSource, _ := ctx.Value(eventSourceKey{}).(string)
+	return eventSource
+}
+
+// WithImporterName returns a copy of ctx carrying importerName.
+func WithImporterName(ctx context.Context, importerName string) context.Context {
+	return context.WithValue(ctx, importerNameKey{}, importerName)
+}
+
+// ImporterName returns the importer name carried by ctx, or "" if none was
+// set.
+func ImporterName(ctx context.Context) string {
+	importerName, _ := ctx.Value(importerNameKey{}).(string)
+	return importerName
+}
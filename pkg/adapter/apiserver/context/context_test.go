@@ -0,0 +1,48 @@
+/*
+Copyright 2019 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package context
+
+import (
+	"context"
+	"testing"
+)
+
+func TestWithAndGet(t *testing.T) {
+	ctx := context.Background()
+
+	if got := Namespace(ctx); got != "" {
+		t.Errorf("Namespace() on an empty context = %q, want \"\"", got)
+	}
+
+	ctx = WithNamespace(ctx, "testns")
+	ctx = WithEventType(ctx, "dev.knative.apiserver.ref.add")
+	ctx = WithEventSource(ctx, "unit-test")
+	ctx = WithImporterName(ctx, "my-importer")
+
+	if got, want := Namespace(ctx), "testns"; got != want {
+		t.Errorf("Namespace() = %q, want %q", got, want)
+	}
+	if got, want := EventType(ctx), "dev.knative.apiserver.ref.add"; got != want {
+		t.Errorf("EventType() = %q, want %q", got, want)
+	}
+	if got, want := EventSource(ctx), "unit-test"; got != want {
+		t.Errorf("EventSource() = %q, want %q", got, want)
+	}
+	if got, want := ImporterName(ctx), "my-importer"; got != want {
+		t.Errorf("ImporterName() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,93 @@
+/*
+Copyright 2019 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package converters turns a watched Kubernetes object into the CloudEvent
+// the apiserver adapter sends to its sink.
+package converters
+
+import (
+	"context"
+	"fmt"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// eventTypePrefix is prepended to the watch action (add/update/delete) to
+// build the CloudEvent type.
+const eventTypePrefix = "dev.knative.apiserver."
+
+// newEvent builds the CloudEvent common to every converter: an ID and
+// subject taken from obj, and a type and source describing what was
+// watched and why this event was emitted.
+func newEvent(obj *unstructured.Unstructured, action string) cloudevents.Event {
+	event := cloudevents.NewEvent()
+	event.SetID(string(obj.GetUID()))
+	event.SetType(eventTypePrefix + action)
+	event.SetSource(eventSource(obj))
+	event.SetSubject(obj.GetName())
+	return event
+}
+
+// eventSource builds the CloudEvent source identifying the watched object.
+func eventSource(obj *unstructured.Unstructured) string {
+	return obj.GetAPIVersion() + "/" + obj.GetKind() + "/" + obj.GetNamespace() + "/" + obj.GetName()
+}
+
+// Converter turns a watched object into the CloudEvent the adapter forwards
+// to its sink.
+type Converter interface {
+	Convert(ctx context.Context, obj *unstructured.Unstructured, action string) (cloudevents.Event, error)
+}
+
+// ConvertFunc is a function that implements Converter.
+type ConvertFunc func(ctx context.Context, obj *unstructured.Unstructured, action string) (cloudevents.Event, error)
+
+// Convert implements Converter.
+func (f ConvertFunc) Convert(ctx context.Context, obj *unstructured.Unstructured, action string) (cloudevents.Event, error) {
+	return f(ctx, obj, action)
+}
+
+// Names of the built-in converters, selected via the CONVERTER envconfig
+// value.
+const (
+	RefName       = "ref"
+	ResourceName  = "resource"
+	JSONPatchName = "jsonpatch"
+
+	// defaultConverter is used when CONVERTER is unset.
+	defaultConverter = RefName
+)
+
+// Registry maps a CONVERTER name to the Converter that implements it.
+var Registry = map[string]Converter{
+	RefName:       ConvertFunc(toRef),
+	ResourceName:  ConvertFunc(toResource),
+	JSONPatchName: ConvertFunc(toJSONPatch),
+}
+
+// FromName looks up the Converter registered under name, defaulting to the
+// ref converter when name is empty.
+func FromName(name string) (Converter, error) {
+	if name == "" {
+		name = defaultConverter
+	}
+	c, ok := Registry[name]
+	if !ok {
+		return nil, fmt.Errorf("converters: no converter registered for name %q", name)
+	}
+	return c, nil
+}
@@ -0,0 +1,63 @@
+/*
+Copyright 2019 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package converters
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFromName(t *testing.T) {
+	tests := []struct {
+		name    string
+		want    Converter
+		wantErr bool
+	}{{
+		name: "",
+		want: Registry[RefName],
+	}, {
+		name: RefName,
+		want: Registry[RefName],
+	}, {
+		name: ResourceName,
+		want: Registry[ResourceName],
+	}, {
+		name: JSONPatchName,
+		want: Registry[JSONPatchName],
+	}, {
+		name:    "bogus",
+		wantErr: true,
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c, err := FromName(test.name)
+			if test.wantErr {
+				if err == nil {
+					t.Fatal("FromName() expected an error, got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("FromName() returned an unexpected error: %v", err)
+			}
+			if reflect.ValueOf(c).Pointer() != reflect.ValueOf(test.want).Pointer() {
+				t.Errorf("FromName(%q) returned a different converter than expected", test.name)
+			}
+		})
+	}
+}
@@ -0,0 +1,65 @@
+/*
+Copyright 2019 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package converters
+
+import (
+	"context"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// operation is a single RFC 6902 JSON Patch operation.
+type operation struct {
+	Op    string      `json:"op"`
+	Path  string      `json:"path"`
+	Value interface{} `json:"value,omitempty"`
+}
+
+// jsonPatchOp maps a watch action to the JSON Patch operation describing it.
+func jsonPatchOp(action string) string {
+	switch action {
+	case "delete":
+		return "remove"
+	case "update":
+		return "replace"
+	default:
+		return "add"
+	}
+}
+
+// toJSONPatch converts obj into a CloudEvent whose data is a one-operation
+// JSON Patch list at path "/": "add" for an add, "replace" for an update and
+// "remove" for a delete. The add and replace operations carry the whole
+// object as their value; remove carries no value. No diff between the old
+// and new object is computed, so an update carries the full new object.
+func toJSONPatch(ctx context.Context, obj *unstructured.Unstructured, action string) (cloudevents.Event, error) {
+	event := newEvent(obj, action)
+
+	op := operation{
+		Op:   jsonPatchOp(action),
+		Path: "/",
+	}
+	if op.Op != "remove" {
+		op.Value = obj.Object
+	}
+
+	if err := event.SetData(cloudevents.ApplicationJSON, []operation{op}); err != nil {
+		return cloudevents.Event{}, err
+	}
+	return event, nil
+}
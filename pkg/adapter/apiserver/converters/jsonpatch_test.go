@@ -0,0 +1,73 @@
+/*
+Copyright 2019 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package converters
+
+import (
+	"context"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestToJSONPatch(t *testing.T) {
+	obj := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "Pod",
+			"metadata": map[string]interface{}{
+				"namespace": "default",
+				"name":      "mypod",
+			},
+		},
+	}
+
+	tests := []struct {
+		action  string
+		wantOp  string
+		wantNil bool
+	}{
+		{action: "add", wantOp: "add"},
+		{action: "update", wantOp: "replace"},
+		{action: "delete", wantOp: "remove", wantNil: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.action, func(t *testing.T) {
+			event, err := toJSONPatch(context.Background(), obj, test.action)
+			if err != nil {
+				t.Fatalf("toJSONPatch() returned an unexpected error: %v", err)
+			}
+
+			var ops []operation
+			if err := event.DataAs(&ops); err != nil {
+				t.Fatalf("failed to unmarshal event data: %v", err)
+			}
+			if len(ops) != 1 {
+				t.Fatalf("got %d operations, want 1", len(ops))
+			}
+			if ops[0].Op != test.wantOp {
+				t.Errorf("operation = %q, want %q", ops[0].Op, test.wantOp)
+			}
+			if test.wantNil && ops[0].Value != nil {
+				t.Errorf("operation value = %+v, want nil", ops[0].Value)
+			}
+			if !test.wantNil && ops[0].Value == nil {
+				t.Error("operation value was nil, want non-nil")
+			}
+		})
+	}
+}
@@ -0,0 +1,44 @@
+/*
+Copyright 2019 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package converters
+
+import (
+	"context"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// toRef converts obj into a CloudEvent carrying a corev1.ObjectReference to
+// it, rather than the object itself.
+func toRef(ctx context.Context, obj *unstructured.Unstructured, action string) (cloudevents.Event, error) {
+	event := newEvent(obj, action)
+
+	ref := corev1.ObjectReference{
+		Kind:            obj.GetKind(),
+		Namespace:       obj.GetNamespace(),
+		Name:            obj.GetName(),
+		UID:             obj.GetUID(),
+		APIVersion:      obj.GetAPIVersion(),
+		ResourceVersion: obj.GetResourceVersion(),
+	}
+	if err := event.SetData(cloudevents.ApplicationJSON, ref); err != nil {
+		return cloudevents.Event{}, err
+	}
+	return event, nil
+}
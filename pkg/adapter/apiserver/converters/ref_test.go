@@ -0,0 +1,62 @@
+/*
+Copyright 2019 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package converters
+
+import (
+	"context"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestToRef(t *testing.T) {
+	obj := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "Pod",
+			"metadata": map[string]interface{}{
+				"namespace":       "default",
+				"name":            "mypod",
+				"uid":             "1234",
+				"resourceVersion": "1",
+			},
+		},
+	}
+
+	event, err := toRef(context.Background(), obj, "add")
+	if err != nil {
+		t.Fatalf("toRef() returned an unexpected error: %v", err)
+	}
+
+	var ref corev1.ObjectReference
+	if err := event.DataAs(&ref); err != nil {
+		t.Fatalf("failed to unmarshal event data: %v", err)
+	}
+
+	want := corev1.ObjectReference{
+		Kind:            "Pod",
+		Namespace:       "default",
+		Name:            "mypod",
+		UID:             "1234",
+		APIVersion:      "v1",
+		ResourceVersion: "1",
+	}
+	if ref != want {
+		t.Errorf("toRef() data = %+v, want %+v", ref, want)
+	}
+}
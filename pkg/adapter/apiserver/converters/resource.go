@@ -0,0 +1,34 @@
+/*
+Copyright 2019 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package converters
+
+import (
+	"context"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// toResource converts obj into a CloudEvent carrying the full object, as
+// opposed to just a reference to it.
+func toResource(ctx context.Context, obj *unstructured.Unstructured, action string) (cloudevents.Event, error) {
+	event := newEvent(obj, action)
+	if err := event.SetData(cloudevents.ApplicationJSON, obj.Object); err != nil {
+		return cloudevents.Event{}, err
+	}
+	return event, nil
+}
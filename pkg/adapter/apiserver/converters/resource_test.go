@@ -0,0 +1,52 @@
+/*
+Copyright 2019 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package converters
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestToResource(t *testing.T) {
+	obj := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "Pod",
+			"metadata": map[string]interface{}{
+				"namespace": "default",
+				"name":      "mypod",
+			},
+		},
+	}
+
+	event, err := toResource(context.Background(), obj, "update")
+	if err != nil {
+		t.Fatalf("toResource() returned an unexpected error: %v", err)
+	}
+
+	var got map[string]interface{}
+	if err := event.DataAs(&got); err != nil {
+		t.Fatalf("failed to unmarshal event data: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, obj.Object) {
+		t.Errorf("toResource() data = %+v, want %+v", got, obj.Object)
+	}
+}
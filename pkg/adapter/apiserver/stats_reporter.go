@@ -18,12 +18,19 @@ package apiserver
 
 import (
 	"context"
+	"net/http"
+	"strconv"
+	"time"
 
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/cloudevents/sdk-go/v2/protocol"
+	cehttp "github.com/cloudevents/sdk-go/v2/protocol/http"
 	"knative.dev/pkg/metrics/metricskey"
 
 	"go.opencensus.io/stats"
 	"go.opencensus.io/stats/view"
 	"go.opencensus.io/tag"
+	apiservercontext "knative.dev/eventing/pkg/adapter/apiserver/context"
 	metricsKeyEventing "knative.dev/eventing/pkg/metrics/metricskey"
 	"knative.dev/pkg/metrics"
 )
@@ -36,23 +43,30 @@ var (
 		"Number of events created",
 		stats.UnitDimensionless,
 	)
+
+	// eventDispatchTimeM records the time spent dispatching an event to
+	// a sink, in milliseconds.
+	eventDispatchTimeM = stats.Float64(
+		"event_dispatch_latencies",
+		"The time spent dispatching an event to a sink",
+		stats.UnitMilliseconds,
+	)
+
 	_ StatsReporter = (*reporter)(nil)
 )
 
-type ReportArgs struct {
-	ns                string
-	eventType         string
-	eventSource       string
-	apiServerImporter string
-}
-
 const (
 	importerResourceGroupValue = "apiserversources.sources.eventing.knative.dev"
 )
 
-// StatsReporter defines the interface for sending filter metrics.
+// StatsReporter defines the interface for sending filter metrics. The
+// namespace, event type, event source and importer name tags are read off
+// ctx, set there by the adapter via pkg/adapter/apiserver/context.
 type StatsReporter interface {
-	ReportEventCount(args *ReportArgs, err error) error
+	ReportEventCount(ctx context.Context, result protocol.Result) error
+	// ReportEventDispatchTime records the time spent dispatching an event
+	// to the sink, tagged with the HTTP response code the sink returned.
+	ReportEventDispatchTime(ctx context.Context, result protocol.Result, d time.Duration) error
 }
 
 // reporter holds cached metric objects to report filter metrics.
@@ -63,6 +77,8 @@ type reporter struct {
 	importerNameTagKey          tag.Key
 	importerResourceGroupTagKey tag.Key
 	resultKey                   tag.Key
+	responseCodeKey             tag.Key
+	responseCodeClassKey        tag.Key
 }
 
 // NewStatsReporter creates a reporter that collects and reports apiserversource
@@ -107,6 +123,18 @@ func NewStatsReporter() (StatsReporter, error) {
 	}
 	r.resultKey = resultTag
 
+	responseCodeTag, err := tag.NewKey(metricsKeyEventing.LabelResponseCode)
+	if err != nil {
+		return nil, err
+	}
+	r.responseCodeKey = responseCodeTag
+
+	responseCodeClassTag, err := tag.NewKey(metricsKeyEventing.LabelResponseCodeClass)
+	if err != nil {
+		return nil, err
+	}
+	r.responseCodeClassKey = responseCodeClassTag
+
 	// Create view to see our measurements.
 	err = view.Register(
 		&view.View{
@@ -116,6 +144,14 @@ func NewStatsReporter() (StatsReporter, error) {
 			TagKeys: []tag.Key{r.namespaceTagKey, r.eventSourceTagKey,
 				r.eventTypeTagKey, r.importerNameTagKey, r.importerResourceGroupTagKey},
 		},
+		&view.View{
+			Description: eventDispatchTimeM.Description(),
+			Measure:     eventDispatchTimeM,
+			Aggregation: view.Distribution(10, 100, 1000, 10000, 100000, 1000000),
+			TagKeys: []tag.Key{r.namespaceTagKey, r.eventSourceTagKey,
+				r.eventTypeTagKey, r.importerNameTagKey, r.importerResourceGroupTagKey,
+				r.responseCodeKey, r.responseCodeClassKey},
+		},
 	)
 	if err != nil {
 		return nil, err
@@ -125,26 +161,78 @@ func NewStatsReporter() (StatsReporter, error) {
 }
 
 // ReportEventCount captures the event count.
-func (r *reporter) ReportEventCount(args *ReportArgs, err error) error {
-	ctx, err := r.generateTag(args, tag.Insert(r.resultKey, Result(err)))
-	if err != nil {
-		return err
+func (r *reporter) ReportEventCount(ctx context.Context, result protocol.Result) error {
+	tagCtx, tagErr := r.generateTag(ctx,
+		tag.Insert(r.resultKey, resultValue(result)),
+		tag.Insert(r.importerResourceGroupTagKey, importerResourceGroupValue))
+	if tagErr != nil {
+		return tagErr
 	}
-	ctx, err = r.generateTag(args, tag.Insert(r.importerResourceGroupTagKey,
-		importerResourceGroupValue))
+	metrics.Record(tagCtx, eventCountM.M(1))
+	return nil
+}
+
+// ReportEventDispatchTime captures the time it took to dispatch the event.
+func (r *reporter) ReportEventDispatchTime(ctx context.Context, result protocol.Result, d time.Duration) error {
+	responseCode := statusCode(result)
+	tagCtx, err := r.generateTag(ctx,
+		tag.Insert(r.responseCodeKey, strconv.Itoa(responseCode)),
+		tag.Insert(r.responseCodeClassKey, responseCodeClass(responseCode)),
+		tag.Insert(r.importerResourceGroupTagKey, importerResourceGroupValue))
 	if err != nil {
 		return err
 	}
-	metrics.Record(ctx, eventCountM.M(1))
+	// convert time.Duration in nanoseconds to milliseconds
+	metrics.Record(tagCtx, eventDispatchTimeM.M(float64(d/time.Millisecond)))
 	return nil
 }
 
-func (r *reporter) generateTag(args *ReportArgs, t tag.Mutator) (context.Context, error) {
-	return tag.New(
-		context.Background(),
-		tag.Insert(r.namespaceTagKey, args.ns),
-		tag.Insert(r.eventSourceTagKey, args.eventSource),
-		tag.Insert(r.eventTypeTagKey, args.eventType),
-		tag.Insert(r.importerNameTagKey, args.apiServerImporter),
-		t)
+// responseCodeClass converts an HTTP response code to its class, e.g. 200 -> 2xx.
+func responseCodeClass(responseCode int) string {
+	class := responseCode / 100
+	return strconv.Itoa(class) + "xx"
+}
+
+// resultValue converts a CloudEvents send result into the "success"/"error"
+// value recorded under the result tag.
+func resultValue(result protocol.Result) string {
+	if cloudevents.IsACK(result) {
+		return "success"
+	}
+	return "error"
+}
+
+// statusCode extracts the HTTP status code a send result carries, falling
+// back to a generic ack/nack status when the result did not originate from
+// the HTTP binding.
+func statusCode(result protocol.Result) int {
+	var httpResult *cehttp.Result
+	if cehttp.ResultAs(result, &httpResult) {
+		return httpResult.StatusCode
+	}
+	if cloudevents.IsACK(result) {
+		return http.StatusOK
+	}
+	return http.StatusInternalServerError
+}
+
+// generateTag reads the namespace/event type/event source/importer name
+// tags off ctx, using metricskey.Any for any of them that is unset, and adds
+// t on top of them.
+func (r *reporter) generateTag(ctx context.Context, t ...tag.Mutator) (context.Context, error) {
+	mutators := append([]tag.Mutator{
+		tag.Insert(r.namespaceTagKey, valueOrAny(apiservercontext.Namespace(ctx))),
+		tag.Insert(r.eventSourceTagKey, valueOrAny(apiservercontext.EventSource(ctx))),
+		tag.Insert(r.eventTypeTagKey, valueOrAny(apiservercontext.EventType(ctx))),
+		tag.Insert(r.importerNameTagKey, valueOrAny(apiservercontext.ImporterName(ctx))),
+	}, t...)
+	return tag.New(ctx, mutators...)
+}
+
+// valueOrAny returns v, or metricskey.Any when v is empty.
+func valueOrAny(v string) string {
+	if v == "" {
+		return metricsKeyEventing.Any
+	}
+	return v
 }
@@ -17,8 +17,13 @@ limitations under the License.
 package apiserver
 
 import (
+	"context"
 	"testing"
+	"time"
 
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	cehttp "github.com/cloudevents/sdk-go/v2/protocol/http"
+	apiservercontext "knative.dev/eventing/pkg/adapter/apiserver/context"
 	"knative.dev/eventing/pkg/metrics/metricskey"
 	"knative.dev/pkg/metrics/metricstest"
 )
@@ -28,15 +33,13 @@ import (
 // Since golang executes test iterations within the same process, the stats reporter
 // returns an error if the metric is already registered and the test panics.
 func unregister() {
-	metricstest.Unregister("event_count")
+	metricstest.Unregister("event_count", "event_dispatch_latencies")
 }
 
 func TestStatsReporter(t *testing.T) {
-	args := &ReportArgs{
-		ns:          "testns",
-		eventType:   "dev.knative.apiserver.ref.delete",
-		eventSource: "unit-test",
-	}
+	ctx := apiservercontext.WithNamespace(context.Background(), "testns")
+	ctx = apiservercontext.WithEventType(ctx, "dev.knative.apiserver.ref.delete")
+	ctx = apiservercontext.WithEventSource(ctx, "unit-test")
 
 	r, err := NewStatsReporter()
 	if err != nil {
@@ -57,10 +60,10 @@ func TestStatsReporter(t *testing.T) {
 
 	// test ReportEventCount
 	expectSuccess(t, func() error {
-		return r.ReportEventCount(args, nil)
+		return r.ReportEventCount(ctx, cloudevents.ResultACK)
 	})
 	expectSuccess(t, func() error {
-		return r.ReportEventCount(args, nil)
+		return r.ReportEventCount(ctx, cloudevents.ResultACK)
 	})
 	metricstest.CheckCountData(t, "event_count", wantTags1, 2)
 
@@ -74,11 +77,7 @@ func TestReporterEmptySourceAndType(t *testing.T) {
 		t.Fatalf("Failed to create a new reporter: %v", err)
 	}
 
-	args := &ReportArgs{
-		ns:          "testns",
-		eventType:   "",
-		eventSource: "",
-	}
+	ctx := apiservercontext.WithNamespace(context.Background(), "testns")
 
 	wantTags := map[string]string{
 		metricskey.NamespaceName: "testns",
@@ -89,20 +88,45 @@ func TestReporterEmptySourceAndType(t *testing.T) {
 
 	// test ReportEventCount
 	expectSuccess(t, func() error {
-		return r.ReportEventCount(args, nil)
+		return r.ReportEventCount(ctx, cloudevents.ResultACK)
 	})
 	expectSuccess(t, func() error {
-		return r.ReportEventCount(args, nil)
+		return r.ReportEventCount(ctx, cloudevents.ResultACK)
 	})
 	expectSuccess(t, func() error {
-		return r.ReportEventCount(args, nil)
+		return r.ReportEventCount(ctx, cloudevents.ResultACK)
 	})
 	expectSuccess(t, func() error {
-		return r.ReportEventCount(args, nil)
+		return r.ReportEventCount(ctx, cloudevents.ResultACK)
 	})
 	metricstest.CheckCountData(t, "event_count", wantTags, 4)
 }
 
+func TestStatsReporter_ReportEventDispatchTime(t *testing.T) {
+	ctx := apiservercontext.WithNamespace(context.Background(), "testns")
+	ctx = apiservercontext.WithEventType(ctx, "dev.knative.apiserver.ref.delete")
+	ctx = apiservercontext.WithEventSource(ctx, "unit-test")
+
+	r, err := NewStatsReporter()
+	if err != nil {
+		t.Fatalf("Failed to create a new reporter: %v", err)
+	}
+	defer unregister()
+
+	wantTags := map[string]string{
+		metricskey.NamespaceName:          "testns",
+		metricskey.EventType:              "dev.knative.apiserver.ref.delete",
+		metricskey.EventSource:            "unit-test",
+		metricskey.LabelResponseCode:      "202",
+		metricskey.LabelResponseCodeClass: "2xx",
+	}
+
+	expectSuccess(t, func() error {
+		return r.ReportEventDispatchTime(ctx, cehttp.NewResult(202, "accepted"), 1500*time.Millisecond)
+	})
+	metricstest.CheckDistributionData(t, "event_dispatch_latencies", wantTags, 1, 1500, 1500)
+}
+
 func expectSuccess(t *testing.T, f func() error) {
 	t.Helper()
 	if err := f(); err != nil {